@@ -0,0 +1,82 @@
+// Package attest signs and verifies Documentation artifacts so consumers can
+// confirm a doc came from a trusted generation run and hasn't been tampered
+// with since.
+package attest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IsaacWLloyd/cogent/shared"
+)
+
+// ErrUnsupportedBackend is returned when a SigningConfig names a backend
+// this build doesn't have support for wired up
+var ErrUnsupportedBackend = errors.New("attest: unsupported signing backend")
+
+// ErrNotAttested is returned by Verify when the Documentation carries no
+// DocumentationAttestation to check
+var ErrNotAttested = errors.New("attest: documentation has no attestation")
+
+// ErrSignatureMismatch is returned by Verify when the attestation's
+// signature does not match its subject
+var ErrSignatureMismatch = errors.New("attest: signature does not match subject")
+
+// ErrUntrustedKey is returned by Verify when the attestation was signed
+// with a key other than the one the caller expects, e.g. a freshly minted
+// key an attacker used to re-sign tampered or hallucinated content
+var ErrUntrustedKey = errors.New("attest: attestation signed with an untrusted key_ref")
+
+// Sign produces a DocumentationAttestation for doc using the given
+// SigningConfig and attaches it to doc.Attestation
+func Sign(doc *shared.Documentation, cfg shared.SigningConfig, builderID, promptTemplateID string, materials []shared.MaterialRef) error {
+	switch cfg.Backend {
+	case "cosign-keyless", "ed25519-local", "kms":
+	default:
+		return ErrUnsupportedBackend
+	}
+
+	subject := subjectDigest(doc, builderID, promptTemplateID, materials)
+	sig, certChain, err := sign(cfg, subject)
+	if err != nil {
+		return err
+	}
+
+	doc.Attestation = &shared.DocumentationAttestation{
+		Predicate:        predicateType,
+		Subject:          subject,
+		BuilderID:        builderID,
+		PromptTemplateID: promptTemplateID,
+		Materials:        materials,
+		Signature:        sig,
+		KeyRef:           cfg.KeyRef,
+		CertChain:        certChain,
+		Timestamp:        time.Now(),
+	}
+	return nil
+}
+
+// Verify checks that doc's attestation was signed by trustedKeyRef (the key
+// a consumer actually trusts, typically the Project's
+// ProjectConfig.Signing.KeyRef) and that its signature matches its current
+// content, builder, prompt template, and materials. It returns an error if
+// the doc is unsigned, was signed under a different key_ref, the key is
+// unknown, or any attested field has changed since signing. Without the
+// trustedKeyRef check, anyone could re-sign tampered content with a freshly
+// minted keypair and have it verify successfully.
+func Verify(doc *shared.Documentation, trustedKeyRef string) error {
+	if doc.Attestation == nil {
+		return ErrNotAttested
+	}
+	a := doc.Attestation
+	if a.KeyRef != trustedKeyRef {
+		return ErrUntrustedKey
+	}
+	if subjectDigest(doc, a.BuilderID, a.PromptTemplateID, a.Materials) != a.Subject {
+		return ErrSignatureMismatch
+	}
+	if !verifySignature(a.KeyRef, a.Signature, a.Subject, a.CertChain) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
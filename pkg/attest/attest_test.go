@@ -0,0 +1,112 @@
+package attest
+
+import (
+	"testing"
+
+	"github.com/IsaacWLloyd/cogent/shared"
+)
+
+func signedDoc(t *testing.T) *shared.Documentation {
+	t.Helper()
+	doc := &shared.Documentation{
+		ID:      "doc-1",
+		Content: "# Hello\n\nSome generated docs.",
+	}
+	cfg := shared.SigningConfig{Backend: "ed25519-local", KeyRef: "keystore-test/" + t.Name()}
+	materials := []shared.MaterialRef{{URI: "file://src/main.go", Digest: "sha256:abc"}}
+	if err := Sign(doc, cfg, "claude-test-model", "prompt-v1", materials); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return doc
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	doc := signedDoc(t)
+	if err := Verify(doc, doc.Attestation.KeyRef); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if doc.Attestation.Timestamp.IsZero() {
+		t.Fatal("Attestation.Timestamp was not set by Sign")
+	}
+}
+
+func TestVerifyUnattested(t *testing.T) {
+	doc := &shared.Documentation{ID: "doc-1", Content: "no attestation"}
+	if err := Verify(doc, "any-key"); err != ErrNotAttested {
+		t.Fatalf("Verify() = %v, want ErrNotAttested", err)
+	}
+}
+
+func TestVerifyDetectsContentTamper(t *testing.T) {
+	doc := signedDoc(t)
+	doc.Content = "tampered content"
+	if err := Verify(doc, doc.Attestation.KeyRef); err != ErrSignatureMismatch {
+		t.Fatalf("Verify() = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestVerifyDetectsBuilderIDTamper(t *testing.T) {
+	doc := signedDoc(t)
+	doc.Attestation.BuilderID = "some-other-model"
+	if err := Verify(doc, doc.Attestation.KeyRef); err != ErrSignatureMismatch {
+		t.Fatalf("Verify() = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestVerifyDetectsPromptTemplateTamper(t *testing.T) {
+	doc := signedDoc(t)
+	doc.Attestation.PromptTemplateID = "some-other-template"
+	if err := Verify(doc, doc.Attestation.KeyRef); err != ErrSignatureMismatch {
+		t.Fatalf("Verify() = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestVerifyDetectsMaterialsTamper(t *testing.T) {
+	doc := signedDoc(t)
+	doc.Attestation.Materials = append(doc.Attestation.Materials, shared.MaterialRef{URI: "file://injected.go", Digest: "sha256:evil"})
+	if err := Verify(doc, doc.Attestation.KeyRef); err != ErrSignatureMismatch {
+		t.Fatalf("Verify() = %v, want ErrSignatureMismatch", err)
+	}
+}
+
+func TestSignUnsupportedBackend(t *testing.T) {
+	doc := &shared.Documentation{ID: "doc-1", Content: "x"}
+	err := Sign(doc, shared.SigningConfig{Backend: "nonsense"}, "model", "template", nil)
+	if err != ErrUnsupportedBackend {
+		t.Fatalf("Sign() = %v, want ErrUnsupportedBackend", err)
+	}
+}
+
+func TestSignRequiresKeyRef(t *testing.T) {
+	doc := &shared.Documentation{ID: "doc-1", Content: "x"}
+	err := Sign(doc, shared.SigningConfig{Backend: "ed25519-local"}, "model", "template", nil)
+	if err != ErrKeyRefRequired {
+		t.Fatalf("Sign() = %v, want ErrKeyRefRequired", err)
+	}
+}
+
+// TestVerifyRejectsForgedResign confirms that re-signing tampered/
+// hallucinated content with a freshly minted keypair under a key_ref the
+// consumer never trusted does not pass Verify against the original
+// trusted key_ref — the scenario that defeated the old
+// embed-the-pubkey-in-the-signature scheme.
+func TestVerifyRejectsForgedResign(t *testing.T) {
+	doc := signedDoc(t)
+	trustedKeyRef := doc.Attestation.KeyRef
+
+	doc.Content = "hallucinated content an attacker wants to pass off as genuine"
+	forged := shared.SigningConfig{Backend: "ed25519-local", KeyRef: "attacker-own-key/" + t.Name()}
+	if err := Sign(doc, forged, doc.Attestation.BuilderID, doc.Attestation.PromptTemplateID, doc.Attestation.Materials); err != nil {
+		t.Fatalf("Sign (forged): %v", err)
+	}
+
+	// The forged attestation is internally self-consistent (it verifies
+	// against its own key_ref)...
+	if err := Verify(doc, doc.Attestation.KeyRef); err != nil {
+		t.Fatalf("Verify(forged, forged key_ref) = %v, want nil", err)
+	}
+	// ...but a consumer that only trusts the original key_ref must reject it.
+	if err := Verify(doc, trustedKeyRef); err != ErrUntrustedKey {
+		t.Fatalf("Verify(forged, trusted key_ref) = %v, want ErrUntrustedKey", err)
+	}
+}
@@ -0,0 +1,93 @@
+package attest
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/IsaacWLloyd/cogent/shared"
+)
+
+const predicateType = "https://cogent.dev/attestations/documentation/v1"
+
+// subjectDigest returns a content-addressed hash over every attested claim
+// (predicate, builder, prompt template, materials, and a digest of the
+// generated content) rather than content alone, so tampering with any one
+// of those fields after signing is detectable by Verify.
+func subjectDigest(doc *shared.Documentation, builderID, promptTemplateID string, materials []shared.MaterialRef) string {
+	contentSum := sha256.Sum256([]byte(doc.Content))
+
+	sortedMaterials := append([]shared.MaterialRef(nil), materials...)
+	sort.Slice(sortedMaterials, func(i, j int) bool { return sortedMaterials[i].URI < sortedMaterials[j].URI })
+
+	var b strings.Builder
+	b.WriteString(predicateType)
+	b.WriteByte('\n')
+	b.WriteString(builderID)
+	b.WriteByte('\n')
+	b.WriteString(promptTemplateID)
+	b.WriteByte('\n')
+	for _, m := range sortedMaterials {
+		b.WriteString(m.URI)
+		b.WriteByte('=')
+		b.WriteString(m.Digest)
+		b.WriteByte('\n')
+	}
+	b.WriteString(hex.EncodeToString(contentSum[:]))
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// ErrKeyRefRequired is returned when the ed25519-local backend, which anchors
+// verification to a persisted key, is used without a KeyRef naming which key
+// to sign/verify with (kms is not wired up and returns ErrUnsupportedBackend)
+var ErrKeyRefRequired = errors.New("attest: cfg.KeyRef is required for this backend")
+
+// sign dispatches to the configured backend. cosign-keyless and kms require
+// out-of-process calls to Fulcio/Rekor or a KMS respectively and are not
+// wired up in this build; ed25519-local is a self-contained reference
+// implementation for local development and tests, anchored to a key
+// persisted in the local keystore under cfg.KeyRef.
+func sign(cfg shared.SigningConfig, subject string) (signature string, certChain []string, err error) {
+	switch cfg.Backend {
+	case "ed25519-local":
+		if cfg.KeyRef == "" {
+			return "", nil, ErrKeyRefRequired
+		}
+		return signEd25519Local(cfg.KeyRef, subject)
+	case "cosign-keyless", "kms":
+		return "", nil, ErrUnsupportedBackend
+	default:
+		return "", nil, ErrUnsupportedBackend
+	}
+}
+
+// verifySignature checks signature against subject using the public key
+// persisted under keyRef, so a forged re-signing with a freshly minted
+// keypair (rather than the key the consumer actually trusts) is rejected.
+func verifySignature(keyRef, signature, subject string, certChain []string) bool {
+	pub, err := localVerifyingKey(keyRef)
+	if err != nil {
+		return false
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(pub, []byte(subject), sig)
+}
+
+// signEd25519Local signs subject with the key persisted under keyRef in the
+// local keystore, generating that key on first use
+func signEd25519Local(keyRef, subject string) (string, []string, error) {
+	priv, err := localSigningKey(keyRef)
+	if err != nil {
+		return "", nil, err
+	}
+	sig := ed25519.Sign(priv, []byte(subject))
+	return hex.EncodeToString(sig), nil, nil
+}
@@ -0,0 +1,58 @@
+package attest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"sync"
+)
+
+// ErrUnknownKeyRef is returned when a signature names a KeyRef the local
+// keystore has never seen, so there is no public key to verify against
+var ErrUnknownKeyRef = errors.New("attest: unknown key_ref")
+
+// localKeystore is a reference implementation of a persisted signing-key
+// store for the ed25519-local backend: keys are generated once per KeyRef
+// and held for the life of the process, so verification is anchored to a
+// key identified by cfg.KeyRef rather than one embedded in the signature
+// payload itself. Production deployments should back this with a real
+// keystore (file, KMS, project-level key table) instead of process memory.
+var localKeystore = struct {
+	mu   sync.RWMutex
+	keys map[string]ed25519.PrivateKey
+}{keys: make(map[string]ed25519.PrivateKey)}
+
+// localSigningKey returns the persisted private key for keyRef, generating
+// and storing one on first use
+func localSigningKey(keyRef string) (ed25519.PrivateKey, error) {
+	localKeystore.mu.RLock()
+	priv, ok := localKeystore.keys[keyRef]
+	localKeystore.mu.RUnlock()
+	if ok {
+		return priv, nil
+	}
+
+	localKeystore.mu.Lock()
+	defer localKeystore.mu.Unlock()
+	if priv, ok := localKeystore.keys[keyRef]; ok {
+		return priv, nil
+	}
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	localKeystore.keys[keyRef] = priv
+	return priv, nil
+}
+
+// localVerifyingKey returns the persisted public key for keyRef, or
+// ErrUnknownKeyRef if no key has been generated/registered under that ref
+func localVerifyingKey(keyRef string) (ed25519.PublicKey, error) {
+	localKeystore.mu.RLock()
+	defer localKeystore.mu.RUnlock()
+	priv, ok := localKeystore.keys[keyRef]
+	if !ok {
+		return nil, ErrUnknownKeyRef
+	}
+	return priv.Public().(ed25519.PublicKey), nil
+}
@@ -6,9 +6,10 @@ package shared
 type MCPToolName string
 
 const (
-	MCPToolSearchDocs    MCPToolName = "search_documentation"
-	MCPToolGetContext    MCPToolName = "get_context"
+	MCPToolSearchDocs        MCPToolName = "search_documentation"
+	MCPToolGetContext        MCPToolName = "get_context"
 	MCPToolValidateRelevance MCPToolName = "validate_relevance"
+	MCPToolVerifyDocs        MCPToolName = "verify_documentation"
 )
 
 // MCPToolDefinition represents a tool definition for the MCP protocol
@@ -31,30 +32,45 @@ type MCPToolResponse struct {
 	Error   string      `json:"error,omitempty"`
 }
 
-// ContextRequest represents a request for context injection
+// Requestor identifies who is calling an MCP tool, so handlers can consult
+// the Project's PermissionTargets before returning or mutating content
+type Requestor struct {
+	UserID  string   `json:"user_id,omitempty"`
+	TeamIDs []string `json:"team_ids,omitempty"`
+	APIKey  string   `json:"api_key,omitempty"`
+}
+
+// ContextRequest represents a request for context injection. The Requestor
+// is checked against the Project's PermissionTargets by get_context before
+// any Documentation is included in the response. Scopes, when set,
+// restricts results to documentation tagged with an intersecting scope (see
+// Documentation.Scopes); an empty Scopes matches all scopes.
 type ContextRequest struct {
-	ProjectID     string   `json:"project_id"`
-	CurrentFile   string   `json:"current_file,omitempty"`
-	Query         string   `json:"query"`
-	MaxTokens     int      `json:"max_tokens,omitempty"`
-	FileTypes     []string `json:"file_types,omitempty"`
-	IncludePaths  []string `json:"include_paths,omitempty"`
-	ExcludePaths  []string `json:"exclude_paths,omitempty"`
+	ProjectID    string    `json:"project_id"`
+	CurrentFile  string    `json:"current_file,omitempty"`
+	Query        string    `json:"query"`
+	MaxTokens    int       `json:"max_tokens,omitempty"`
+	FileTypes    []string  `json:"file_types,omitempty"`
+	IncludePaths []string  `json:"include_paths,omitempty"`
+	ExcludePaths []string  `json:"exclude_paths,omitempty"`
+	Scopes       []string  `json:"scopes,omitempty"`
+	Requestor    Requestor `json:"requestor"`
 }
 
 // ContextResponse represents the context provided to Claude Code
 type ContextResponse struct {
-	Context   string           `json:"context"`
-	Sources   []ContextSource  `json:"sources"`
-	TokenUsed int              `json:"tokens_used"`
+	Context   string          `json:"context"`
+	Sources   []ContextSource `json:"sources"`
+	TokenUsed int             `json:"tokens_used"`
 }
 
 // ContextSource represents a source of context information
 type ContextSource struct {
-	FilePath    string  `json:"file_path"`
-	LineRange   string  `json:"line_range,omitempty"`
-	Relevance   float64 `json:"relevance"`
-	Summary     string  `json:"summary"`
+	FilePath  string   `json:"file_path"`
+	LineRange string   `json:"line_range,omitempty"`
+	Relevance float64  `json:"relevance"`
+	Summary   string   `json:"summary"`
+	Scopes    []string `json:"scopes,omitempty"` // the source's Documentation.Scopes, for display
 }
 
 // RelevanceValidationRequest represents a request to validate search result relevance
@@ -67,4 +83,17 @@ type RelevanceValidationRequest struct {
 type RelevanceValidationResponse struct {
 	FilteredResults []SearchResult `json:"filtered_results"`
 	Reasoning       string         `json:"reasoning"`
+}
+
+// VerifyDocumentationRequest represents a request to verify a Documentation
+// artifact's attestation before a caller trusts it as injected context
+type VerifyDocumentationRequest struct {
+	DocumentationID string `json:"documentation_id"`
+}
+
+// VerifyDocumentationResponse reports whether a Documentation's attestation
+// is present and its signature matches its current content
+type VerifyDocumentationResponse struct {
+	Verified bool   `json:"verified"`
+	Reason   string `json:"reason,omitempty"`
 }
\ No newline at end of file
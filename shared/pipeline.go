@@ -0,0 +1,73 @@
+package shared
+
+import "time"
+
+// Pipeline expresses documentation generation for a Project as an ordered
+// graph of Stages rather than a single generate-on-hook step
+type Pipeline struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Stages []Stage `json:"stages"`
+}
+
+// StageType identifies what kind of work a Stage performs
+type StageType string
+
+const (
+	StageExtract           StageType = "extract"
+	StageSummarize         StageType = "summarize"
+	StageDiagram           StageType = "diagram"
+	StageCrossReference    StageType = "cross_reference"
+	StageValidateRelevance StageType = "validate_relevance"
+	StagePublish           StageType = "publish"
+)
+
+// Stage is one node in a Pipeline. DependsOn names the stages that must
+// finish first; stages that do not depend on each other can run in
+// parallel. Each may override the model/provider used for
+// generation so cheap models can handle extraction while a stronger one
+// handles synthesis.
+type Stage struct {
+	Name      string        `json:"name"`
+	Type      StageType     `json:"type"`
+	DependsOn []string      `json:"depends_on,omitempty"`
+	Inputs    []string      `json:"inputs,omitempty"`
+	Outputs   []string      `json:"outputs,omitempty"`
+	Timeout   time.Duration `json:"timeout,omitempty"`
+	Model     string        `json:"model,omitempty"`
+	Provider  string        `json:"provider,omitempty"`
+}
+
+// PipelineRunStatus reflects the overall progress of a PipelineRun
+type PipelineRunStatus string
+
+const (
+	PipelineRunPending   PipelineRunStatus = "pending"
+	PipelineRunRunning   PipelineRunStatus = "running"
+	PipelineRunSucceeded PipelineRunStatus = "succeeded"
+	PipelineRunFailed    PipelineRunStatus = "failed"
+)
+
+// StageResult records the outcome of executing a single Stage within a
+// PipelineRun
+type StageResult struct {
+	StageName string            `json:"stage_name"`
+	Status    PipelineRunStatus `json:"status"`
+	Output    string            `json:"output,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	StartedAt time.Time         `json:"started_at"`
+	EndedAt   time.Time         `json:"ended_at,omitempty"`
+}
+
+// PipelineRun is the compiled execution record for one HookEvent processed
+// through a Pipeline, replacing direct emission of a single Documentation
+type PipelineRun struct {
+	RunID        string            `json:"run_id"`
+	PipelineID   string            `json:"pipeline_id"`
+	ProjectID    string            `json:"project_id"`
+	Status       PipelineRunStatus `json:"status"`
+	StageResults []StageResult     `json:"stage_results"`
+	Usage        []Usage           `json:"usage,omitempty"`
+	StartedAt    time.Time         `json:"started_at"`
+	EndedAt      time.Time         `json:"ended_at,omitempty"`
+}
@@ -6,14 +6,60 @@ import (
 
 // Project represents a code project that COGENT is managing
 type Project struct {
-	ID              string            `json:"id" db:"id"`
-	Name            string            `json:"name" db:"name"`
-	Path            string            `json:"path" db:"path"`
-	UserID          string            `json:"user_id" db:"user_id"`
-	APIKey          string            `json:"api_key" db:"api_key"`
-	Config          ProjectConfig     `json:"config" db:"config"`
-	CreatedAt       time.Time         `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time         `json:"updated_at" db:"updated_at"`
+	ID                string             `json:"id" db:"id"`
+	Name              string             `json:"name" db:"name"`
+	Path              string             `json:"path" db:"path"`
+	UserID            string             `json:"user_id" db:"user_id"`
+	APIKeys           []APIKeyGrant      `json:"api_keys" db:"api_keys"`
+	PermissionTargets []PermissionTarget `json:"permission_targets" db:"permission_targets"`
+	Config            ProjectConfig      `json:"config" db:"config"`
+	CreatedAt         time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// Permission represents a single capability that can be granted on a
+// Project's documentation
+type Permission string
+
+const (
+	PermRead     Permission = "read"
+	PermWrite    Permission = "write"
+	PermAnnotate Permission = "annotate"
+	PermDelete   Permission = "delete"
+	PermManage   Permission = "manage"
+)
+
+// PermissionTargetType identifies what a PermissionTarget grants access to
+type PermissionTargetType string
+
+const (
+	TargetUser   PermissionTargetType = "user"
+	TargetTeam   PermissionTargetType = "team"
+	TargetAPIKey PermissionTargetType = "api_key"
+)
+
+// PermissionTarget binds a user, team, or API-key scope to a set of
+// Permissions on a Project, optionally restricted to a subset of the
+// project's documentation via path globs. Replaces the old binary
+// ProjectVisibility flag with per-grantee, per-path access control.
+type PermissionTarget struct {
+	ID           string               `json:"id"`
+	Type         PermissionTargetType `json:"type"`
+	TargetID     string               `json:"target_id"` // user ID, team ID, or API key ID
+	Permissions  []Permission         `json:"permissions"`
+	IncludeGlobs []string             `json:"include_globs,omitempty"`
+	ExcludeGlobs []string             `json:"exclude_globs,omitempty"`
+}
+
+// APIKeyGrant is a single API key issued for a Project, scoped to a set of
+// Permissions and optionally expiring
+type APIKeyGrant struct {
+	ID          string       `json:"id"`
+	Key         string       `json:"key"`
+	Label       string       `json:"label"`
+	Permissions []Permission `json:"permissions"`
+	ExpiresAt   *time.Time   `json:"expires_at,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
 }
 
 // ProjectConfig holds project-specific settings
@@ -21,8 +67,18 @@ type ProjectConfig struct {
 	IncludePatterns    []string                `json:"include_patterns"`
 	ExcludePatterns    []string                `json:"exclude_patterns"`
 	DocumentationStyle DocumentationStyle      `json:"documentation_style"`
-	Visibility         ProjectVisibility       `json:"visibility"`
 	Templates          map[string]string       `json:"templates"`
+	WebhookSelectors   []WebhookSelector       `json:"webhook_selectors,omitempty"`
+	Signing            SigningConfig           `json:"signing,omitempty"`
+	PipelineTemplates  map[string]Pipeline     `json:"pipeline_templates,omitempty"`
+	WorkflowPolicy     WorkflowPolicy          `json:"workflow_policy,omitempty"`
+}
+
+// SigningConfig controls how generated Documentation is signed so its
+// provenance can later be verified with pkg/attest
+type SigningConfig struct {
+	Backend string `json:"backend"` // "cosign-keyless", "ed25519-local", "kms"
+	KeyRef  string `json:"key_ref,omitempty"` // key ID or KMS ARN, unused for cosign-keyless
 }
 
 // DocumentationStyle defines the format and detail level for generated docs
@@ -32,14 +88,6 @@ type DocumentationStyle struct {
 	Language    string `json:"language"`    // "javascript", "python", "go", etc.
 }
 
-// ProjectVisibility controls who can access the project documentation
-type ProjectVisibility string
-
-const (
-	VisibilityPrivate ProjectVisibility = "private"
-	VisibilityPublic  ProjectVisibility = "public"
-)
-
 // User represents a user of the COGENT system
 type User struct {
 	ID        string    `json:"id" db:"id"`
@@ -53,23 +101,74 @@ type User struct {
 
 // Documentation represents a generated documentation file
 type Documentation struct {
-	ID        string    `json:"id" db:"id"`
-	ProjectID string    `json:"project_id" db:"project_id"`
-	FilePath  string    `json:"file_path" db:"file_path"`
-	Content   string    `json:"content" db:"content"`
-	Hash      string    `json:"hash" db:"hash"` // Hash of source file for change detection
+	ID               string                    `json:"id" db:"id"`
+	ProjectID        string                    `json:"project_id" db:"project_id"`
+	FilePath         string                    `json:"file_path" db:"file_path"`
+	Content          string                    `json:"content" db:"content"`
+	Hash             string                    `json:"hash" db:"hash"` // Hash of source file for change detection
+	Version          int                       `json:"version" db:"version"`
+	ParentVersion    *string                   `json:"parent_version,omitempty" db:"parent_version"`
+	State            DocumentationState        `json:"state" db:"state"`
+	Transitions      []WorkflowTransition      `json:"transitions,omitempty" db:"transitions"`
+	Materializations []Materialization         `json:"materializations,omitempty" db:"materializations"`
+	Attestation      *DocumentationAttestation `json:"attestation,omitempty" db:"attestation"`
+	// Scopes are the deployment-scope tags this doc applies to (e.g.
+	// "innerloop", "outerloop", "runtime", "test", "deploy"), populated at
+	// generation time from file-path heuristics or explicit frontmatter.
+	// MCPSearchRequest.Scopes and ContextRequest.Scopes filter against this
+	// same set.
+	Scopes    []string  `json:"scopes,omitempty" db:"scopes"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
-// HookEvent represents an event that triggers documentation generation
+// DocumentationState tracks a Documentation artifact's position in its
+// review/publication lifecycle
+type DocumentationState string
+
+const (
+	StateDraft     DocumentationState = "draft"
+	StateInReview  DocumentationState = "in_review"
+	StateApproved  DocumentationState = "approved"
+	StatePublished DocumentationState = "published"
+	StateArchived  DocumentationState = "archived"
+)
+
+// MaterialRef identifies one input that contributed to a generated
+// Documentation artifact, for inclusion in its attestation's Materials
+type MaterialRef struct {
+	URI    string `json:"uri"`
+	Digest string `json:"digest"` // e.g. "sha256:..."
+}
+
+// DocumentationAttestation is an in-toto-style attestation describing how a
+// Documentation artifact was produced, so consumers can verify it wasn't
+// tampered with or hallucinated after generation
+type DocumentationAttestation struct {
+	Predicate        string        `json:"predicate"` // attestation predicate type URI
+	Subject          string        `json:"subject"`    // digest over the predicate, builder, prompt template, materials, and content
+	BuilderID        string        `json:"builder_id"` // LLM/model that produced the doc
+	PromptTemplateID string        `json:"prompt_template_id"`
+	Materials        []MaterialRef `json:"materials"`
+	Signature        string        `json:"signature"`
+	KeyRef           string        `json:"key_ref"` // identifies the persisted signing key Signature must verify against
+	CertChain        []string      `json:"cert_chain,omitempty"`
+	Timestamp        time.Time     `json:"timestamp"`
+}
+
+// HookEvent represents an event that triggers documentation generation. The
+// handler compiles matching events into a PipelineRun rather than emitting
+// Documentation directly, so a single event can drive a multi-stage
+// generation Pipeline.
 type HookEvent struct {
-	Type      HookEventType `json:"type"`
-	Timestamp time.Time     `json:"timestamp"`
-	Tool      string        `json:"tool"`
-	FilePath  string        `json:"file_path"`
-	Content   string        `json:"content,omitempty"`
-	Changes   []FileChange  `json:"changes,omitempty"`
+	Type      HookEventType   `json:"type"`
+	Source    HookEventSource `json:"source"`
+	Timestamp time.Time       `json:"timestamp"`
+	Tool      string          `json:"tool"`
+	FilePath  string          `json:"file_path"`
+	Content   string          `json:"content,omitempty"`
+	Changes   []FileChange    `json:"changes,omitempty"`
+	Webhook   *WebhookPayload `json:"webhook,omitempty"`
 }
 
 // HookEventType defines the types of hook events
@@ -78,8 +177,50 @@ type HookEventType string
 const (
 	EventTypePostToolUse HookEventType = "post_tool_use"
 	EventTypePreCommit   HookEventType = "pre_commit"
+	EventTypePush        HookEventType = "push"
+	EventTypePullRequest HookEventType = "pull_request"
+	EventTypeMerge       HookEventType = "merge"
+	EventTypeTag         HookEventType = "tag"
+	EventTypeRelease     HookEventType = "release"
 )
 
+// HookEventSource identifies what triggered a HookEvent, so downstream
+// handlers can route it appropriately
+type HookEventSource string
+
+const (
+	HookSourceClaudeTool HookEventSource = "claude_tool"
+	HookSourceGitWebhook HookEventSource = "git_webhook"
+	HookSourceManual     HookEventSource = "manual"
+)
+
+// WebhookPayload carries the fields of an incoming Git-hosting webhook that
+// selectors can match against and handlers can act on
+type WebhookPayload struct {
+	RepositoryURL string            `json:"repository_url"`
+	SourceBranch  string            `json:"source_branch,omitempty"`
+	TargetBranch  string            `json:"target_branch,omitempty"`
+	Author        string            `json:"author,omitempty"`
+	CommitDate    time.Time         `json:"commit_date,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Merged        bool              `json:"merged,omitempty"`
+}
+
+// WebhookSelector filters incoming webhook payloads so only matching events
+// trigger documentation regeneration for a Project. Empty fields are
+// treated as wildcards.
+type WebhookSelector struct {
+	ID            string            `json:"id"`
+	EventTypes    []HookEventType   `json:"event_types,omitempty"`
+	RepositoryURL string            `json:"repository_url,omitempty"`
+	SourceBranch  string            `json:"source_branch,omitempty"` // glob, e.g. "feature/*"
+	TargetBranch  string            `json:"target_branch,omitempty"` // glob, e.g. "main"
+	Author        string            `json:"author,omitempty"`
+	HeaderMatch   map[string]string `json:"header_match,omitempty"`
+	MergedOnly    bool              `json:"merged_only,omitempty"`
+	NotBeforeDate *time.Time        `json:"not_before_date,omitempty"`
+}
+
 // FileChange represents a change to a file
 type FileChange struct {
 	Operation string `json:"operation"` // "create", "update", "delete"
@@ -88,12 +229,18 @@ type FileChange struct {
 	NewHash   string `json:"new_hash,omitempty"`
 }
 
-// MCPSearchRequest represents a search request from the MCP server
+// MCPSearchRequest represents a search request from the MCP server. The
+// Requestor identifies who is asking so search_documentation can consult the
+// Project's PermissionTargets before returning results. Scopes, when set,
+// restricts results to documentation tagged with an intersecting scope (see
+// Documentation.Scopes); an empty Scopes matches all scopes.
 type MCPSearchRequest struct {
-	ProjectID string   `json:"project_id"`
-	Query     string   `json:"query"`
-	FileTypes []string `json:"file_types,omitempty"`
-	MaxResults int     `json:"max_results,omitempty"`
+	ProjectID  string    `json:"project_id"`
+	Query      string    `json:"query"`
+	FileTypes  []string  `json:"file_types,omitempty"`
+	MaxResults int       `json:"max_results,omitempty"`
+	Scopes     []string  `json:"scopes,omitempty"`
+	Requestor  Requestor `json:"requestor"`
 }
 
 // MCPSearchResponse represents search results returned to the MCP server
@@ -104,11 +251,12 @@ type MCPSearchResponse struct {
 
 // SearchResult represents a single search result
 type SearchResult struct {
-	FilePath    string  `json:"file_path"`
-	Content     string  `json:"content"`
-	Relevance   float64 `json:"relevance"`
-	LineNumbers []int   `json:"line_numbers,omitempty"`
-	Summary     string  `json:"summary,omitempty"`
+	FilePath    string   `json:"file_path"`
+	Content     string   `json:"content"`
+	Relevance   float64  `json:"relevance"`
+	LineNumbers []int    `json:"line_numbers,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Scopes      []string `json:"scopes,omitempty"` // the result's Documentation.Scopes, for display
 }
 
 // APIResponse is a standard response wrapper for API endpoints
@@ -0,0 +1,43 @@
+package shared
+
+import "time"
+
+// WorkflowTransition audits a single move of a Documentation artifact
+// between DocumentationStates
+type WorkflowTransition struct {
+	FromState DocumentationState `json:"from_state"`
+	ToState   DocumentationState `json:"to_state"`
+	ActorID   string             `json:"actor_id"` // user ID, or "system" for automated transitions
+	Reason    string             `json:"reason,omitempty"`
+	Timestamp time.Time          `json:"timestamp"`
+}
+
+// MaterializationFormat identifies the concrete output shape a
+// Materialization renders a Documentation into
+type MaterializationFormat string
+
+const (
+	MaterializationMarkdown     MaterializationFormat = "markdown"
+	MaterializationHTML         MaterializationFormat = "html"
+	MaterializationVectorChunks MaterializationFormat = "vector_chunks"
+	MaterializationOpenAPI      MaterializationFormat = "openapi_fragment"
+	MaterializationMermaidSVG   MaterializationFormat = "mermaid_svg"
+)
+
+// Materialization is one concrete, independently rebuildable rendering of a
+// logical Documentation artifact
+type Materialization struct {
+	ID       string                `json:"id"`
+	Format   MaterializationFormat `json:"format"`
+	Location string                `json:"location"` // file path, URL, or storage key
+	Hash     string                `json:"hash"`
+	BuiltAt  time.Time             `json:"built_at"`
+}
+
+// WorkflowPolicy governs how Documentation moves through its review
+// lifecycle for a Project
+type WorkflowPolicy struct {
+	AutoApproveThreshold float64 `json:"auto_approve_threshold"` // relevance/confidence score above which drafts auto-approve
+	RequiredReviewers    int     `json:"required_reviewers"`     // reviewers needed before a doc can move from in_review to approved
+	RetentionCount       int     `json:"retention_count"`        // prior versions to keep before pruning
+}